@@ -0,0 +1,65 @@
+package golog
+
+import (
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// gologPackagePrefix is used by `getCaller` to skip over golog's own
+// frames (the print wrapper methods) so the reported frame is always
+// the user's call site.
+const gologPackagePrefix = "github.com/kataras/golog."
+
+var callerPCsPool = sync.Pool{
+	New: func() interface{} {
+		pcs := make([]uintptr, 16)
+		return &pcs
+	},
+}
+
+// getCaller walks the call stack and returns the first frame that doesn't
+// belong to the golog package itself, i.e. the actual call site of the
+// Print/Info/Warn/Error/Debug/Log method that ended up here.
+//
+// It's only meant to be called when `Logger.ReportCaller` is true, walking
+// the stack on every log is expensive.
+func getCaller() *runtime.Frame {
+	pcsPtr := callerPCsPool.Get().(*[]uintptr)
+	defer callerPCsPool.Put(pcsPtr)
+	pcs := *pcsPtr
+
+	// skip=1 to not report `runtime.Callers` itself, golog's own
+	// frames are filtered out below through the package path check.
+	n := runtime.Callers(1, pcs)
+	if n == 0 {
+		return nil
+	}
+
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, gologPackagePrefix) {
+			return &frame
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return nil
+}
+
+// formatCaller renders "caller" as "file:line", trimmed to the base file
+// name unless "full" is true.
+func formatCaller(caller *runtime.Frame, full bool) string {
+	file := caller.File
+	if !full {
+		file = filepath.Base(file)
+	}
+
+	return file + ":" + strconv.Itoa(caller.Line)
+}
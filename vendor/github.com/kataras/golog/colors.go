@@ -0,0 +1,42 @@
+package golog
+
+import "io"
+
+// consoleState remembers a writer's original Windows console mode so it
+// can be restored by `Logger#Close` once golog enabled virtual terminal
+// processing on it for color support.
+type consoleState struct {
+	writer   io.Writer
+	original uint32
+}
+
+// enableWindowsConsole enables virtual-terminal-processing on "w" if it's
+// a Windows console handle and remembers its original mode, so that colors
+// render correctly on older Windows consoles instead of as garbage. It's a
+// no-op on non-Windows platforms and on writers that aren't a console.
+func (l *Logger) enableWindowsConsole(w io.Writer) {
+	original, changed := enableVirtualTerminal(w)
+	if !changed {
+		return
+	}
+
+	l.mu.Lock()
+	l.consoleStates = append(l.consoleStates, consoleState{writer: w, original: original})
+	l.mu.Unlock()
+}
+
+// colorsEnabled reports whether colored output should be used, given
+// whether the destination writer was auto-detected as a terminal.
+// `DisableColors` takes precedence over `ForceColors`, which in turn
+// overrides the auto-detected "isTerminal" value.
+func (l *Logger) colorsEnabled(isTerminal bool) bool {
+	if l.DisableColors {
+		return false
+	}
+
+	if l.ForceColors {
+		return true
+	}
+
+	return isTerminal
+}
@@ -0,0 +1,123 @@
+package golog
+
+import "fmt"
+
+// Entry is returned by `Logger#WithField` and `Logger#WithFields` and carries
+// a set of `Fields` that are attached to every log it prints. It exposes the
+// same leveled print methods as `Logger` so structured logging composes
+// naturally with the rest of the API.
+type Entry struct {
+	Logger *Logger
+	Fields Fields
+}
+
+// WithField returns a new `Entry`, a clone of this one, with "key" and "value"
+// merged into its `Fields`. The original Entry's Fields is left untouched.
+func (e *Entry) WithField(key string, value interface{}) *Entry {
+	return e.WithFields(Fields{key: value})
+}
+
+// WithFields returns a new `Entry`, a clone of this one, with "fields" merged
+// into its `Fields`. The original Entry's Fields is left untouched.
+func (e *Entry) WithFields(fields Fields) *Entry {
+	return &Entry{
+		Logger: e.Logger,
+		Fields: e.Fields.merge(fields),
+	}
+}
+
+// Print prints a log message without levels and colors, with the Entry's Fields attached.
+func (e *Entry) Print(v ...interface{}) {
+	e.Logger.printFields(DisableLevel, fmt.Sprint(v...), false, e.Fields)
+}
+
+// Println prints a log message without levels and colors, with the Entry's Fields attached.
+// It adds a new line at the end.
+func (e *Entry) Println(v ...interface{}) {
+	e.Logger.printFields(DisableLevel, fmt.Sprint(v...), true, e.Fields)
+}
+
+// Log prints a leveled log message to the output, with the Entry's Fields attached.
+// It adds a new line in the end.
+func (e *Entry) Log(level Level, v ...interface{}) {
+	e.Logger.printFields(level, fmt.Sprint(v...), true, e.Fields)
+}
+
+// Logf prints a leveled log message to the output, with the Entry's Fields attached.
+// It adds a new line in the end.
+func (e *Entry) Logf(level Level, format string, args ...interface{}) {
+	e.Log(level, fmt.Sprintf(format, args...))
+}
+
+// Error will print only when logger's Level is error.
+func (e *Entry) Error(v ...interface{}) {
+	e.Log(ErrorLevel, v...)
+}
+
+// Errorf will print only when logger's Level is error.
+func (e *Entry) Errorf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	e.Error(msg)
+}
+
+// Warn will print when logger's Level is error, or warning.
+func (e *Entry) Warn(v ...interface{}) {
+	e.Log(WarnLevel, v...)
+}
+
+// Warnf will print when logger's Level is error, or warning.
+func (e *Entry) Warnf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	e.Warn(msg)
+}
+
+// Info will print when logger's Level is error, warning or info.
+func (e *Entry) Info(v ...interface{}) {
+	e.Log(InfoLevel, v...)
+}
+
+// Infof will print when logger's Level is error, warning or info.
+func (e *Entry) Infof(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	e.Info(msg)
+}
+
+// Debug will print when logger's Level is error, warning, info or debug.
+func (e *Entry) Debug(v ...interface{}) {
+	e.Log(DebugLevel, v...)
+}
+
+// Debugf will print when logger's Level is error, warning, info or debug.
+func (e *Entry) Debugf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	e.Debug(msg)
+}
+
+// Fatal prints the log, with the Entry's Fields attached, and then runs the
+// Logger's registered exit handlers, which exits the program with code 1.
+func (e *Entry) Fatal(v ...interface{}) {
+	e.Log(FatalLevel, v...)
+	e.Logger.DeferExitHandlers(1)
+}
+
+// Fatalf prints the log, with the Entry's Fields attached, and then runs the
+// Logger's registered exit handlers, which exits the program with code 1.
+func (e *Entry) Fatalf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	e.Fatal(msg)
+}
+
+// Panic prints the log, with the Entry's Fields attached, and then panics
+// with the same message.
+func (e *Entry) Panic(v ...interface{}) {
+	msg := fmt.Sprint(v...)
+	e.Log(PanicLevel, msg)
+	panic(msg)
+}
+
+// Panicf prints the log, with the Entry's Fields attached, and then panics
+// with the same message.
+func (e *Entry) Panicf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	e.Panic(msg)
+}
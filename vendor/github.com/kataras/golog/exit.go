@@ -0,0 +1,34 @@
+package golog
+
+import "os"
+
+// RegisterExitHandler registers a cleanup function to be run by
+// `DeferExitHandlers`, i.e. to flush a buffered output or close a remote
+// sink, before the program exits through `Fatal`/`Fatalf`. Handlers run
+// in the order they were registered.
+func (l *Logger) RegisterExitHandler(handler func()) {
+	l.mu.Lock()
+	l.exitHandlers = append(l.exitHandlers, handler)
+	l.mu.Unlock()
+}
+
+// DeferExitHandlers runs every handler registered through
+// `RegisterExitHandler` and then exits the program with "code" through
+// the Logger's `ExitFunc` (`os.Exit` by default). It's called
+// automatically by `Fatal`/`Fatalf`.
+func (l *Logger) DeferExitHandlers(code int) {
+	l.mu.Lock()
+	handlers := l.exitHandlers
+	exitFunc := l.ExitFunc
+	l.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler()
+	}
+
+	if exitFunc == nil {
+		exitFunc = os.Exit
+	}
+
+	exitFunc(code)
+}
@@ -0,0 +1,19 @@
+package golog
+
+// Fields is a map of arbitrary key-value pairs that can be attached to
+// a log entry for structured logging, i.e. `logger.WithFields(golog.Fields{"user": "kataras"}).Info("logged in")`.
+type Fields map[string]interface{}
+
+// merge returns a new Fields value containing "f"'s entries overwritten
+// by "other"'s entries, it's used to make field inheritance (WithField/WithFields
+// on an existing `Entry`) produce a new value instead of mutating the parent's.
+func (f Fields) merge(other Fields) Fields {
+	merged := make(Fields, len(f)+len(other))
+	for k, v := range f {
+		merged[k] = v
+	}
+	for k, v := range other {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,82 @@
+package golog
+
+import (
+	"os"
+	"sync"
+)
+
+// Reopener is implemented by outputs that support atomically reopening
+// their underlying resource, i.e. `*FileWriter`. Outputs registered
+// through `Logger#SetOutput`/`Logger#AddOutput` that implement it are
+// tracked so `Logger#SignalReopen` can rotate them.
+type Reopener interface {
+	Reopen() error
+}
+
+// FileWriter is an `io.Writer` that wraps an `*os.File` opened at "path"
+// and can atomically reopen it through `Reopen`, the standard pattern for
+// cooperating with `logrotate`-style external rotation without restarting
+// the process.
+type FileWriter struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+	err  error
+}
+
+var _ Reopener = (*FileWriter)(nil)
+
+// NewFileWriter opens "path" for appending, creating it if it doesn't
+// exist, and returns a `*FileWriter` wrapping it. If the file cannot be
+// opened, the error is returned by the first `Write` instead of here, so
+// that it composes with `Logger.SetOutput` the same way any `io.Writer` does.
+func NewFileWriter(path string) *FileWriter {
+	w := &FileWriter{path: path}
+	w.file, w.err = openLogFile(path)
+	return w
+}
+
+func openLogFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+}
+
+// Write implements the `io.Writer` interface. It's safe for concurrent use
+// and serialized with `Reopen` so that nothing is ever written into a
+// closed file descriptor.
+func (w *FileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	return w.file.Write(p)
+}
+
+// Reopen atomically closes the current file and opens "path" again, it's
+// meant to be called after an external tool (i.e. `logrotate`) has moved
+// or removed the original file out from under the process.
+func (w *FileWriter) Reopen() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file != nil {
+		w.file.Close()
+	}
+
+	w.file, w.err = openLogFile(w.path)
+	return w.err
+}
+
+// Close closes the underlying file.
+func (w *FileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+
+	return w.file.Close()
+}
@@ -0,0 +1,62 @@
+package golog
+
+import "testing"
+
+// fakeReopener is an `io.Writer` and `Reopener` that records how many
+// times it was reopened, used in place of a real `*FileWriter` so tests
+// don't touch the filesystem.
+type fakeReopener struct {
+	reopened int
+}
+
+func (w *fakeReopener) Write(p []byte) (int, error) { return len(p), nil }
+
+func (w *fakeReopener) Reopen() error {
+	w.reopened++
+	return nil
+}
+
+// TestTrackReopenerCloneIsolatesSiblings checks that two Loggers cloned from
+// the same root, each given their own `SetOutput`, track only their own
+// writer for rotation and leave the root's reopeners untouched. Because
+// `SetOutput` starts from an empty reopeners slice, this test does not
+// exercise the copy that `trackReopener` makes before appending.
+func TestTrackReopenerCloneIsolatesSiblings(t *testing.T) {
+	root := New()
+	root.AddOutput(&fakeReopener{}, &fakeReopener{}, &fakeReopener{})
+
+	child1 := root.Clone()
+	child2 := root.Clone()
+
+	fwA := &fakeReopener{}
+	fwB := &fakeReopener{}
+	child1.SetOutput(fwA)
+	child2.SetOutput(fwB)
+
+	if got := len(child1.reopeners); got != 1 || child1.reopeners[0] != Reopener(fwA) {
+		t.Fatalf("expected child1.reopeners to contain only fwA, got %v", child1.reopeners)
+	}
+	if got := len(child2.reopeners); got != 1 || child2.reopeners[0] != Reopener(fwB) {
+		t.Fatalf("expected child2.reopeners to contain only fwB, got %v", child2.reopeners)
+	}
+	if len(root.reopeners) != 3 {
+		t.Fatalf("expected root.reopeners to keep its original 3 entries, got %d", len(root.reopeners))
+	}
+}
+
+// TestSetOutputClearsPreviousReopeners guards against a past bug where
+// `SetOutput` replaced the Printer's output writer but left the previous
+// `Reopener` (i.e. a `*FileWriter`) registered forever, so `SignalReopen`
+// kept rotating a file nothing writes to anymore.
+func TestSetOutputClearsPreviousReopeners(t *testing.T) {
+	l := New()
+	old := &fakeReopener{}
+	l.SetOutput(old)
+
+	replacement := &fakeReopener{}
+	l.SetOutput(replacement)
+
+	if got := len(l.reopeners); got != 1 || l.reopeners[0] != Reopener(replacement) {
+		t.Fatalf("expected reopeners to contain only the replacement writer, got %v", l.reopeners)
+	}
+}
@@ -0,0 +1,10 @@
+package golog
+
+// Formatter is responsible for rendering a `Log` value into the final
+// bytes that are written to the Logger's output. The default, zero-value
+// behavior of a `Logger` (no Formatter set) keeps using the original,
+// hard-coded text layout for backwards compatibility; set one explicitly
+// with `Logger#SetFormatter` to opt in, e.g. to `TextFormatter` or `JSONFormatter`.
+type Formatter interface {
+	Format(log *Log) ([]byte, error)
+}
@@ -0,0 +1,51 @@
+package golog
+
+// Hook is an interface which, if registered with `Logger#AddHook`, fires on
+// every print whose `Level` is contained in `Levels()`, in addition to the
+// logger's normal output. Unlike a `Handler`, a Hook cannot suppress the print,
+// it's meant for side-effects such as shipping errors to Sentry or metrics to
+// StatsD while the log still reaches stdout as usual.
+type Hook interface {
+	// Levels returns the Levels that `Fire` should be called for.
+	Levels() []Level
+	// Fire is called with the Log value of every print whose Level
+	// is contained in `Levels`.
+	Fire(log *Log) error
+}
+
+// LevelHooks is a registry of `Hook` values indexed by `Level`, so that
+// firing the hooks for a given Level is an O(1) map lookup.
+type LevelHooks map[Level][]Hook
+
+// Add registers "hook" under every Level it declared interest for.
+func (hooks LevelHooks) Add(hook Hook) {
+	for _, level := range hook.Levels() {
+		hooks[level] = append(hooks[level], hook)
+	}
+}
+
+// Fire fires the hooks registered for "level" with the given "log" value,
+// in registration order. It stops at the first hook that returns an error
+// and returns that error; the hooks after it are not fired.
+func (hooks LevelHooks) Fire(level Level, log *Log) error {
+	for _, hook := range hooks[level] {
+		if err := hook.Fire(log); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clone returns a deep copy of "hooks" - a new map with a new, independent
+// slice per level - so that callers can add to it without mutating the
+// original or any other clone's slices (i.e. a Logger shared with its
+// clones through `Clone`).
+func (hooks LevelHooks) clone() LevelHooks {
+	cloned := make(LevelHooks, len(hooks))
+	for level, levelHooks := range hooks {
+		cloned[level] = append([]Hook(nil), levelHooks...)
+	}
+
+	return cloned
+}
@@ -0,0 +1,56 @@
+package golog
+
+import (
+	"io"
+	"testing"
+)
+
+// countHook is a `Hook` that records how many times it fired, used to
+// assert which hooks a particular Logger actually invokes.
+type countHook struct {
+	levels []Level
+	fired  int
+}
+
+func (h *countHook) Levels() []Level { return h.levels }
+
+func (h *countHook) Fire(log *Log) error {
+	h.fired++
+	return nil
+}
+
+// TestLevelHooksCloneIsolatesSiblings guards against a past bug where
+// `LevelHooks.clone` only copied the outer map and left the per-level
+// `[]Hook` slices aliased, so two Loggers cloned from the same root and
+// each given their own `AddHook` would silently stomp on one another.
+func TestLevelHooksCloneIsolatesSiblings(t *testing.T) {
+	root := New()
+	root.SetOutput(io.Discard)
+	root.AddHook(&countHook{levels: []Level{InfoLevel}})
+	root.AddHook(&countHook{levels: []Level{InfoLevel}})
+	root.AddHook(&countHook{levels: []Level{InfoLevel}})
+
+	child1 := root.Clone()
+	child2 := root.Clone()
+
+	hookA := &countHook{levels: []Level{InfoLevel}}
+	hookB := &countHook{levels: []Level{InfoLevel}}
+	child1.AddHook(hookA)
+	child2.AddHook(hookB)
+
+	child1.Info("from child1")
+	if hookA.fired != 1 {
+		t.Fatalf("expected hookA to fire once for child1, fired %d times", hookA.fired)
+	}
+	if hookB.fired != 0 {
+		t.Fatalf("expected hookB not to fire for child1, fired %d times", hookB.fired)
+	}
+
+	child2.Info("from child2")
+	if hookB.fired != 1 {
+		t.Fatalf("expected hookB to fire once for child2, fired %d times", hookB.fired)
+	}
+	if hookA.fired != 1 {
+		t.Fatalf("expected hookA to stay at 1 fire after child2.Info, fired %d times", hookA.fired)
+	}
+}
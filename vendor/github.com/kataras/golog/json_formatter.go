@@ -0,0 +1,56 @@
+package golog
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JSONFormatter is a `Formatter` implementation that renders each log as a
+// single-line JSON object, useful for feeding log collectors that expect
+// structured input instead of golog's default human-readable text.
+type JSONFormatter struct {
+	// TimeFieldKey is the JSON key used for the timestamp, defaults to "time".
+	TimeFieldKey string
+	// TimeFormat is the time layout used to render the timestamp, it
+	// defaults to `time.RFC3339` and is intentionally independent of the
+	// Logger's `TimeFormat`, which is tailored for the human-readable
+	// `TextFormatter` rather than for log collectors.
+	TimeFormat string
+	// DisableTimestamp skips the timestamp field entirely when set to true.
+	DisableTimestamp bool
+}
+
+var _ Formatter = (*JSONFormatter)(nil)
+
+// Format implements the `Formatter` interface.
+func (f *JSONFormatter) Format(log *Log) ([]byte, error) {
+	entry := make(map[string]interface{}, len(log.Fields)+4)
+	for k, v := range log.Fields {
+		entry[k] = v
+	}
+
+	if !f.DisableTimestamp {
+		timeKey := f.TimeFieldKey
+		if timeKey == "" {
+			timeKey = "time"
+		}
+
+		timeFormat := f.TimeFormat
+		if timeFormat == "" {
+			timeFormat = time.RFC3339
+		}
+
+		entry[timeKey] = log.Time.Format(timeFormat)
+	}
+
+	entry["level"] = log.Level.String()
+	entry["msg"] = log.Message
+	if prefix := string(log.Logger.Prefix); prefix != "" {
+		entry["prefix"] = prefix
+	}
+	if log.Caller != nil {
+		entry["caller"] = formatCaller(log.Caller, log.Logger.FullCallerPath)
+	}
+
+	return json.Marshal(entry)
+}
@@ -0,0 +1,61 @@
+package golog
+
+// Level is the type for the log levels, it's an alias of uint32.
+//
+// The zero value, `DisableLevel`, turns off every leveled print method of a
+// Logger; `Print` and `Println` log at `DisableLevel` and are still printed.
+// Every other Level gates the leveled print methods through
+// `Logger#Level >= level`, so a lower Level value means the message is more
+// severe and therefore shown even when the Logger is configured to be
+// relatively quiet.
+type Level uint32
+
+const (
+	// DisableLevel will disable the printer.
+	DisableLevel Level = iota
+	// PanicLevel will print the log and then panic with the same message,
+	// for errors that the program cannot recover from.
+	PanicLevel
+	// FatalLevel will print the log and then exit the program,
+	// for errors that require the program to stop.
+	FatalLevel
+	// ErrorLevel will print only on errors.
+	ErrorLevel
+	// WarnLevel will print on errors and warnings.
+	WarnLevel
+	// InfoLevel will print on errors, warnings and info, it's the default Level.
+	InfoLevel
+	// DebugLevel will print everything, useful on development.
+	DebugLevel
+)
+
+var levelNames = map[Level]string{
+	DisableLevel: "disable",
+	PanicLevel:   "panic",
+	FatalLevel:   "fatal",
+	ErrorLevel:   "error",
+	WarnLevel:    "warn",
+	InfoLevel:    "info",
+	DebugLevel:   "debug",
+}
+
+// String returns the text representation of "lvl", i.e "info", "error".
+func (lvl Level) String() string {
+	if name, ok := levelNames[lvl]; ok {
+		return name
+	}
+
+	return levelNames[InfoLevel]
+}
+
+// fromLevelName returns the `Level` for the given "levelName", the reverse
+// of `Level#String`. Defaults to `InfoLevel` if "levelName" is not valid.
+func fromLevelName(levelName string) Level {
+	for lvl, name := range levelNames {
+		if name == levelName {
+			return lvl
+		}
+	}
+
+	return InfoLevel
+}
@@ -0,0 +1,37 @@
+package golog
+
+import (
+	"runtime"
+	"time"
+)
+
+// Log represents a log value, it's the basic info that a golog's `Logger`
+// instance receives and it's being used to the printer's Hijack.
+//
+// Look `Logger#print` for more.
+type Log struct {
+	Logger *Logger
+
+	Level   Level
+	Time    time.Time
+	Message string
+	// NewLine is true if this Log was created by a Println or a leveled (`Log`, `Logf`, `Info`...) call,
+	// it's false when it was created by a `Print` call.
+	NewLine bool
+	// Fields holds the structured key-value pairs attached to this Log,
+	// set through `Logger#WithField`, `Logger#WithFields` or an `Entry`.
+	Fields Fields
+	// Caller holds the file, line and function of the call site that produced
+	// this Log. It's only set when the Logger's `ReportCaller` is true.
+	Caller *runtime.Frame
+}
+
+// FormatTime returns the log's time formatted based on the Logger's `TimeFormat`.
+// Returns empty string if that format is empty.
+func (l *Log) FormatTime() string {
+	if l.Logger.TimeFormat == "" {
+		return ""
+	}
+
+	return l.Time.Format(l.Logger.TimeFormat)
+}
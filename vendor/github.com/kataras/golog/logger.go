@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/kataras/pio"
@@ -29,27 +31,53 @@ type Logger struct {
 	Prefix     []byte
 	Level      Level
 	TimeFormat string
-	mu         sync.Mutex
-	Printer    *pio.Printer
-	handlers   []Handler
-	once       sync.Once
-	logs       sync.Pool
-	children   *loggerMap
+	// Formatter, when set, renders each `Log` instead of golog's
+	// default, hard-coded text layout. See `SetFormatter`.
+	Formatter Formatter
+	// ReportCaller, when true, captures the file, line and function of
+	// the call site for every Log. See `SetReportCaller`.
+	ReportCaller bool
+	// FullCallerPath, when true, renders the reported caller's full file
+	// path instead of just its base name.
+	FullCallerPath bool
+	// ForceColors forces colored output even when the output is not
+	// auto-detected as a terminal.
+	ForceColors bool
+	// DisableColors disables colored output entirely, it takes precedence
+	// over `ForceColors`.
+	DisableColors bool
+	mu            sync.Mutex
+	Printer       *pio.Printer
+	handlers      []Handler
+	hooks         LevelHooks
+	consoleStates []consoleState
+	reopeners     []Reopener
+	// ExitFunc is called, with the process exit code, by `DeferExitHandlers`
+	// after a `Fatal`/`Fatalf` call. Defaults to `os.Exit`, swap it out in
+	// tests to observe a Fatal call without killing the test binary.
+	ExitFunc     func(code int)
+	exitHandlers []func()
+	once         sync.Once
+	logs         sync.Pool
+	children     *loggerMap
 }
 
 // New returns a new golog with a default output to `os.Stdout`
 // and level to `InfoLevel`.
 func New() *Logger {
-	return &Logger{
+	l := &Logger{
 		Level:      InfoLevel,
 		TimeFormat: "2006/01/02 15:04",
+		ExitFunc:   os.Exit,
 		Printer:    pio.NewPrinter("", os.Stdout).EnableDirectOutput().Hijack(logHijacker),
 		children:   newLoggerMap(),
 	}
+	l.enableWindowsConsole(os.Stdout)
+	return l
 }
 
 // acquireLog returns a new log fom the pool.
-func (l *Logger) acquireLog(level Level, msg string, withPrintln bool) *Log {
+func (l *Logger) acquireLog(level Level, msg string, withPrintln bool, fields Fields) *Log {
 	log, ok := l.logs.Get().(*Log)
 	if !ok {
 		log = &Log{
@@ -60,6 +88,12 @@ func (l *Logger) acquireLog(level Level, msg string, withPrintln bool) *Log {
 	log.Time = time.Now()
 	log.Level = level
 	log.Message = msg
+	log.Fields = fields
+	if l.ReportCaller {
+		log.Caller = getCaller()
+	} else {
+		log.Caller = nil
+	}
 	return log
 }
 
@@ -78,7 +112,16 @@ var logHijacker = func(ctx *pio.Ctx) {
 		return
 	}
 
-	line := GetTextForLevel(l.Level, ctx.Printer.IsTerminal)
+	// a Formatter takes over the whole rendering, the hijacker
+	// only keeps its original text layout as the fallback default.
+	if f := l.Logger.Formatter; f != nil {
+		b, err := f.Format(l)
+		ctx.Store(b, err)
+		ctx.Next()
+		return
+	}
+
+	line := GetTextForLevel(l.Level, l.Logger.colorsEnabled(ctx.Printer.IsTerminal))
 	if line != "" {
 		line += " "
 	}
@@ -86,8 +129,17 @@ var logHijacker = func(ctx *pio.Ctx) {
 	if t := l.FormatTime(); t != "" {
 		line += t + " "
 	}
+
+	if l.Caller != nil {
+		line += formatCaller(l.Caller, l.Logger.FullCallerPath) + " "
+	}
+
 	line += l.Message
 
+	if len(l.Fields) > 0 {
+		line += " " + formatFieldsText(l.Fields)
+	}
+
 	var b []byte
 	if pref := l.Logger.Prefix; len(pref) > 0 {
 		b = append(pref, []byte(line)...)
@@ -105,6 +157,14 @@ var NopOutput = pio.NopOutput()
 // SetOutput overrides the Logger's Printer's Output with another `io.Writer`.
 func (l *Logger) SetOutput(w io.Writer) {
 	l.Printer.SetOutput(w)
+	l.enableWindowsConsole(w)
+
+	// the previous output (and its Reopener, if any) is gone, forget it
+	// instead of leaving `SignalReopen` rotating a file nothing writes to.
+	l.mu.Lock()
+	l.reopeners = nil
+	l.mu.Unlock()
+	l.trackReopener(w)
 }
 
 // AddOutput adds one or more `io.Writer` to the Logger's Printer.
@@ -113,6 +173,84 @@ func (l *Logger) SetOutput(w io.Writer) {
 // then colors will be disabled for all outputs.
 func (l *Logger) AddOutput(writers ...io.Writer) {
 	l.Printer.AddOutput(writers...)
+	for _, w := range writers {
+		l.enableWindowsConsole(w)
+		l.trackReopener(w)
+	}
+}
+
+// trackReopener registers "w" so that `SignalReopen` can rotate it,
+// if it implements the `Reopener` interface (i.e. a `*FileWriter`).
+//
+// It copies the Logger's reopeners slice before appending to it, so that a
+// Logger created through `Clone`/`Child` (which shares the same slice at
+// the time of cloning) never mutates its parent's or siblings' reopeners.
+func (l *Logger) trackReopener(w io.Writer) {
+	r, ok := w.(Reopener)
+	if !ok {
+		return
+	}
+
+	l.mu.Lock()
+	reopeners := append([]Reopener(nil), l.reopeners...)
+	l.reopeners = append(reopeners, r)
+	l.mu.Unlock()
+}
+
+// SignalReopen listens for "sig" (defaults to `syscall.SIGHUP` when nil)
+// and calls `Reopen` on every output set through `SetOutput`/`AddOutput`
+// that supports it (i.e. a `*FileWriter`), until the returned "cancel" is
+// fired, once. Child loggers created through `Clone`/`Child` share the
+// same outputs, so a single signal rotates every derived logger too.
+func (l *Logger) SignalReopen(sig os.Signal) (cancel func()) {
+	if sig == nil {
+		sig = syscall.SIGHUP
+	}
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-ch:
+				l.mu.Lock()
+				reopeners := l.reopeners
+				l.mu.Unlock()
+
+				for _, r := range reopeners {
+					r.Reopen()
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(ch)
+			close(done)
+		})
+	}
+}
+
+// Close restores any Windows console modes that golog enabled on its output
+// writers for color support. Safe to call on any platform, it's a no-op
+// wherever nothing was changed (i.e. non-Windows, or a non-console output).
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	states := l.consoleStates
+	l.consoleStates = nil
+	l.mu.Unlock()
+
+	for _, state := range states {
+		restoreConsoleMode(state.writer, state.original)
+	}
+
+	return nil
 }
 
 // SetPrefix sets a prefix for this "l" Logger.
@@ -142,6 +280,8 @@ func (l *Logger) SetTimeFormat(s string) {
 //
 // Available level names are:
 // "disable"
+// "panic"
+// "fatal"
 // "error"
 // "warn"
 // "info"
@@ -155,11 +295,17 @@ func (l *Logger) SetLevel(levelName string) {
 }
 
 func (l *Logger) print(level Level, msg string, newLine bool) {
+	l.printFields(level, msg, newLine, nil)
+}
+
+// printFields is the same as `print` but it also attaches "fields" to the
+// produced `Log`, it's used by `Entry` and the `WithField`/`WithFields` methods.
+func (l *Logger) printFields(level Level, msg string, newLine bool, fields Fields) {
 	if l.Level >= level {
 		// newLine passed here in order for handler to know
 		// if this message derives from Println and Leveled functions
 		// or by simply, Print.
-		log := l.acquireLog(level, msg, newLine)
+		log := l.acquireLog(level, msg, newLine, fields)
 		// if not handled by one of the handler
 		// then print it as usual.
 		if !l.handled(log) {
@@ -170,10 +316,72 @@ func (l *Logger) print(level Level, msg string, newLine bool) {
 			}
 		}
 
+		l.fireHooks(log)
+
 		l.releaseLog(log)
 	}
 }
 
+// fireHooks fires every registered hook whose Levels() contains log.Level,
+// in addition to (not instead of) the normal output above.
+func (l *Logger) fireHooks(log *Log) {
+	if len(l.hooks) == 0 {
+		return
+	}
+
+	if err := l.hooks.Fire(log.Level, log); err != nil {
+		fmt.Fprintf(os.Stderr, "golog: failed to fire hook: %v\n", err)
+	}
+}
+
+// AddHook registers a `Hook`, it will be fired on every print whose Level
+// is contained in the hook's `Levels()`, on top of the normal output.
+//
+// It copies the Logger's hooks map before adding to it, so that a Logger
+// created through `Clone`/`Child` (which shares the same map at the time
+// of cloning) never mutates its parent's or siblings' hooks.
+func (l *Logger) AddHook(hook Hook) {
+	l.mu.Lock()
+	hooks := l.hooks.clone()
+	hooks.Add(hook)
+	l.hooks = hooks
+	l.mu.Unlock()
+}
+
+// SetFormatter sets a `Formatter` to be used instead of golog's default
+// text layout, i.e. `SetFormatter(new(golog.JSONFormatter))`.
+func (l *Logger) SetFormatter(f Formatter) {
+	l.mu.Lock()
+	l.Formatter = f
+	l.mu.Unlock()
+}
+
+// SetReportCaller sets whether the file, line and function of the call
+// site should be captured and reported for every subsequent Log.
+//
+// Walking the call stack has a cost, keep it disabled (the default)
+// unless you need it.
+func (l *Logger) SetReportCaller(reportCaller bool) {
+	l.mu.Lock()
+	l.ReportCaller = reportCaller
+	l.mu.Unlock()
+}
+
+// WithField returns an `Entry` bound to this Logger with "key" set to "value",
+// to be attached on every print call made through that Entry.
+func (l *Logger) WithField(key string, value interface{}) *Entry {
+	return l.WithFields(Fields{key: value})
+}
+
+// WithFields returns an `Entry` bound to this Logger with "fields" attached
+// to every print call made through that Entry.
+func (l *Logger) WithFields(fields Fields) *Entry {
+	return &Entry{
+		Logger: l,
+		Fields: fields,
+	}
+}
+
 // Print prints a log message without levels and colors.
 func (l *Logger) Print(v ...interface{}) {
 	l.print(DisableLevel, fmt.Sprint(v...), false)
@@ -244,6 +452,37 @@ func (l *Logger) Debugf(format string, args ...interface{}) {
 	l.Debug(msg)
 }
 
+// Fatal prints the log, if the logger's Level allows `FatalLevel`, and then
+// runs the registered exit handlers through `DeferExitHandlers`, which exits
+// the program with code 1 regardless of the logger's Level.
+func (l *Logger) Fatal(v ...interface{}) {
+	l.Log(FatalLevel, v...)
+	l.DeferExitHandlers(1)
+}
+
+// Fatalf prints the log, if the logger's Level allows `FatalLevel`, and then
+// runs the registered exit handlers through `DeferExitHandlers`, which exits
+// the program with code 1 regardless of the logger's Level.
+func (l *Logger) Fatalf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	l.Fatal(msg)
+}
+
+// Panic prints the log, if the logger's Level allows `PanicLevel`, and then
+// panics with the same message regardless of the logger's Level.
+func (l *Logger) Panic(v ...interface{}) {
+	msg := fmt.Sprint(v...)
+	l.Log(PanicLevel, msg)
+	panic(msg)
+}
+
+// Panicf prints the log, if the logger's Level allows `PanicLevel`, and then
+// panics with the same message regardless of the logger's Level.
+func (l *Logger) Panicf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	l.Panic(msg)
+}
+
 // Install receives  an external logger
 // and automatically adapts its print functions.
 //
@@ -333,6 +572,15 @@ func (l *Logger) Scan(r io.Reader) (cancel func()) {
 				return nil, pio.ErrMarshalNotResponsible
 			}
 
+			if f := l.Formatter; f != nil {
+				return f.Format(&Log{
+					Logger:  l,
+					Level:   DisableLevel,
+					Time:    time.Now(),
+					Message: string(line),
+				})
+			}
+
 			formattedTime := time.Now().Format(l.TimeFormat)
 			if formattedTime != "" {
 				line = append([]byte(formattedTime+" "), line...)
@@ -349,14 +597,23 @@ func (l *Logger) Scan(r io.Reader) (cancel func()) {
 // This copy is returned as pointer as well.
 func (l *Logger) Clone() *Logger {
 	return &Logger{
-		Prefix:     l.Prefix,
-		Level:      l.Level,
-		TimeFormat: l.TimeFormat,
-		Printer:    l.Printer,
-		handlers:   l.handlers,
-		children:   newLoggerMap(),
-		mu:         sync.Mutex{},
-		once:       sync.Once{},
+		Prefix:         l.Prefix,
+		Level:          l.Level,
+		TimeFormat:     l.TimeFormat,
+		Formatter:      l.Formatter,
+		ReportCaller:   l.ReportCaller,
+		FullCallerPath: l.FullCallerPath,
+		ForceColors:    l.ForceColors,
+		DisableColors:  l.DisableColors,
+		Printer:        l.Printer,
+		handlers:       l.handlers,
+		hooks:          l.hooks,
+		ExitFunc:       l.ExitFunc,
+		exitHandlers:   l.exitHandlers,
+		reopeners:      l.reopeners,
+		children:       newLoggerMap(),
+		mu:             sync.Mutex{},
+		once:           sync.Once{},
 	}
 }
 
@@ -0,0 +1,14 @@
+// +build !windows
+
+package golog
+
+import "io"
+
+// enableVirtualTerminal is a no-op on non-Windows platforms, ANSI sequences
+// are already rendered natively by their terminals.
+func enableVirtualTerminal(w io.Writer) (original uint32, changed bool) {
+	return 0, false
+}
+
+// restoreConsoleMode is a no-op on non-Windows platforms.
+func restoreConsoleMode(w io.Writer, original uint32) {}
@@ -0,0 +1,59 @@
+// +build windows
+
+package golog
+
+import (
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const enableVirtualTerminalProcessing = 0x0004
+
+var (
+	kernel32           = syscall.NewLazyDLL("kernel32.dll")
+	procGetConsoleMode = kernel32.NewProc("GetConsoleMode")
+	procSetConsoleMode = kernel32.NewProc("SetConsoleMode")
+)
+
+// enableVirtualTerminal enables ENABLE_VIRTUAL_TERMINAL_PROCESSING on "w" if
+// it's a Windows console handle, so ANSI color sequences render correctly on
+// cmd.exe and pre-Windows 10 consoles instead of printing as garbage.
+// It returns the console's original mode and whether it actually changed it,
+// so the caller can restore it later through `restoreConsoleMode`.
+func enableVirtualTerminal(w io.Writer) (original uint32, changed bool) {
+	f, ok := w.(*os.File)
+	if !ok {
+		return 0, false
+	}
+
+	handle := syscall.Handle(f.Fd())
+
+	var mode uint32
+	if r, _, _ := procGetConsoleMode.Call(uintptr(handle), uintptr(unsafe.Pointer(&mode))); r == 0 {
+		// not a console handle (i.e. redirected to a file or pipe).
+		return 0, false
+	}
+
+	if mode&enableVirtualTerminalProcessing != 0 {
+		return mode, false
+	}
+
+	if r, _, _ := procSetConsoleMode.Call(uintptr(handle), uintptr(mode|enableVirtualTerminalProcessing)); r == 0 {
+		return mode, false
+	}
+
+	return mode, true
+}
+
+// restoreConsoleMode restores "w"'s console mode to "original",
+// as captured by a previous `enableVirtualTerminal` call.
+func restoreConsoleMode(w io.Writer, original uint32) {
+	f, ok := w.(*os.File)
+	if !ok {
+		return
+	}
+
+	procSetConsoleMode.Call(uintptr(syscall.Handle(f.Fd())), uintptr(original))
+}
@@ -0,0 +1,41 @@
+package golog
+
+var levelText = map[Level]string{
+	PanicLevel: "PANC",
+	FatalLevel: "FTAL",
+	ErrorLevel: "ERRO",
+	WarnLevel:  "WARN",
+	InfoLevel:  "INFO",
+	DebugLevel: "DBUG",
+}
+
+// ansi escape sequences for each level's text, only applied when colors
+// are enabled for the destination output.
+var levelColor = map[Level]string{
+	PanicLevel: "\x1b[41m", // white on red background
+	FatalLevel: "\x1b[31m", // red
+	ErrorLevel: "\x1b[31m", // red
+	WarnLevel:  "\x1b[33m", // yellow
+	InfoLevel:  "\x1b[36m", // cyan
+	DebugLevel: "\x1b[32m", // green
+}
+
+const ansiReset = "\x1b[0m"
+
+// GetTextForLevel returns the level's text, ready to be printed.
+// If "withColors" is true and a color is assigned to that Level,
+// the text is wrapped with its ANSI color sequence.
+func GetTextForLevel(level Level, withColors bool) string {
+	text, ok := levelText[level]
+	if !ok {
+		return ""
+	}
+
+	if withColors {
+		if color, ok := levelColor[level]; ok {
+			return color + text + ansiReset
+		}
+	}
+
+	return text
+}
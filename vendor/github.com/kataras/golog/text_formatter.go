@@ -0,0 +1,70 @@
+package golog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TextFormatter is a `Formatter` implementation, it's the formalization of
+// golog's original, hard-coded human-readable output: level text, time and
+// message, followed by any `Fields` rendered as `key=value` pairs (values
+// that contain a space are quoted).
+type TextFormatter struct {
+	// DisableTimestamp disables the output of the time field.
+	DisableTimestamp bool
+}
+
+var _ Formatter = (*TextFormatter)(nil)
+
+// Format implements the `Formatter` interface.
+func (f *TextFormatter) Format(log *Log) ([]byte, error) {
+	line := GetTextForLevel(log.Level, log.Logger.colorsEnabled(log.Logger.Printer.IsTerminal))
+	if line != "" {
+		line += " "
+	}
+
+	if !f.DisableTimestamp {
+		if t := log.FormatTime(); t != "" {
+			line += t + " "
+		}
+	}
+
+	if log.Caller != nil {
+		line += formatCaller(log.Caller, log.Logger.FullCallerPath) + " "
+	}
+
+	line += log.Message
+
+	if len(log.Fields) > 0 {
+		line += " " + formatFieldsText(log.Fields)
+	}
+
+	var b []byte
+	if pref := log.Logger.Prefix; len(pref) > 0 {
+		b = append(pref, []byte(line)...)
+	} else {
+		b = []byte(line)
+	}
+
+	return b, nil
+}
+
+func formatFieldsText(fields Fields) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := fmt.Sprintf("%v", fields[k])
+		if strings.ContainsAny(v, " \t") {
+			v = fmt.Sprintf("%q", v)
+		}
+		parts = append(parts, k+"="+v)
+	}
+
+	return strings.Join(parts, " ")
+}